@@ -6,15 +6,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"net/url"
 	"os"
 	"os/signal"
-	"strings"
 	"syscall"
 	"time"
 
 	"github.com/rs/zerolog/log"
 
+	"github.com/pojntfx/weron/internal/daemon"
 	"github.com/pojntfx/weron/pkg/services"
 	"github.com/pojntfx/weron/pkg/wrtcchat"
 	"github.com/pojntfx/weron/pkg/wrtcconn"
@@ -50,6 +49,10 @@ func addInterruptHandler(cancel func(), closer io.Closer, before func()) {
 
 		log.Debug().Msg("Gracefully shutting down")
 
+		if err := daemon.Stopping(); err != nil {
+			log.Debug().Err(err).Msg("Could not notify service manager of shutdown, continuing")
+		}
+
 		go func() {
 			<-s
 
@@ -80,16 +83,8 @@ var chatCmd = &cobra.Command{
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
-		if strings.TrimSpace(viper.GetString(communityFlag)) == "" {
-			return errMissingCommunity
-		}
-
-		if strings.TrimSpace(viper.GetString(passwordFlag)) == "" {
-			return errMissingPassword
-		}
-
-		if strings.TrimSpace(viper.GetString(keyFlag)) == "" {
-			return errMissingKey
+		if err := validateCommunityFlags(); err != nil {
+			return err
 		}
 
 		if len(viper.GetStringSlice(namesFlag)) <= 0 {
@@ -98,19 +93,14 @@ var chatCmd = &cobra.Command{
 
 		fmt.Printf(".%v", viper.GetString(raddrFlag))
 
-		u, err := url.Parse(viper.GetString(raddrFlag))
+		u, err := getSignalerURL()
 		if err != nil {
 			return err
 		}
 
-		q := u.Query()
-		q.Set("community", viper.GetString(communityFlag))
-		q.Set("password", viper.GetString(passwordFlag))
-		u.RawQuery = q.Encode()
-
 		id := ""
 		adapter := wrtcchat.NewAdapter(
-			u.String(),
+			u,
 			viper.GetString(keyFlag),
 			viper.GetStringSlice(iceFlag),
 			&wrtcchat.AdapterConfig{
@@ -164,16 +154,10 @@ var chatCmd = &cobra.Command{
 }
 
 func init() {
-	chatCmd.PersistentFlags().String(raddrFlag, "wss://weron.herokuapp.com/", "Remote address")
-	chatCmd.PersistentFlags().Duration(timeoutFlag, time.Second*10, "Time to wait for connections")
-	chatCmd.PersistentFlags().String(communityFlag, "", "ID of community to join")
-	chatCmd.PersistentFlags().String(passwordFlag, "", "Password for community")
-	chatCmd.PersistentFlags().String(keyFlag, "", "Encryption key for community")
+	addCommunityFlags(chatCmd.PersistentFlags())
 	chatCmd.PersistentFlags().StringSlice(namesFlag, []string{}, "Comma-separated list of names to try and claim one from")
 	chatCmd.PersistentFlags().StringSlice(channelsFlag, []string{services.ChatPrimary}, "Comma-separated list of channels in community to join")
 	chatCmd.PersistentFlags().String(idChannelFlag, services.ChatID, "Channel to use to negotiate names")
-	chatCmd.PersistentFlags().StringSlice(iceFlag, []string{"stun:stun.l.google.com:19302"}, "Comma-separated list of STUN servers (in format stun:host:port) and TURN servers to use (in format username:credential@turn:host:port) (i.e. username:credential@turn:global.turn.twilio.com:3478?transport=tcp)")
-	chatCmd.PersistentFlags().Bool(forceRelayFlag, false, "Force usage of TURN servers")
 	chatCmd.PersistentFlags().Duration(kicksFlag, time.Second*5, "Time to wait for kicks")
 
 	viper.AutomaticEnv()
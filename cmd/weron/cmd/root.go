@@ -1,16 +1,20 @@
 package cmd
 
 import (
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 const (
 	verboseFlag = "verbose"
+	configFlag  = "config"
 )
 
 var rootCmd = &cobra.Command{
@@ -29,6 +33,14 @@ https://github.com/pojntfx/weron`,
 			return err
 		}
 
+		if configFile := viper.GetString(configFlag); strings.TrimSpace(configFile) != "" {
+			viper.SetConfigFile(configFile)
+
+			if err := viper.ReadInConfig(); err != nil {
+				return err
+			}
+		}
+
 		verbose := viper.GetInt(verboseFlag)
 		if verbose > 5 {
 			boil.DebugMode = true
@@ -59,6 +71,7 @@ https://github.com/pojntfx/weron`,
 
 func Execute() error {
 	rootCmd.PersistentFlags().IntP(verboseFlag, "v", 5, "Verbosity level (0 is disabled, default is info, 7 is trace)")
+	rootCmd.PersistentFlags().String(configFlag, "", "Config file to read flags from (i.e. /etc/weron/weron.yaml); supports all formats supported by Viper")
 
 	if err := viper.BindPFlags(rootCmd.PersistentFlags()); err != nil {
 		return err
@@ -68,3 +81,43 @@ func Execute() error {
 
 	return rootCmd.Execute()
 }
+
+func addCommunityFlags(f *pflag.FlagSet) {
+	f.String(raddrFlag, "wss://weron.herokuapp.com/", "Remote address")
+	f.Duration(timeoutFlag, time.Second*10, "Time to wait for connections")
+	f.String(communityFlag, "", "ID of community to join")
+	f.String(passwordFlag, "", "Password for community")
+	f.String(keyFlag, "", "Encryption key for community")
+	f.StringSlice(iceFlag, []string{"stun:stun.l.google.com:19302"}, "Comma-separated list of STUN servers (in format stun:host:port) and TURN servers to use (in format username:credential@turn:host:port) (i.e. username:credential@turn:global.turn.twilio.com:3478?transport=tcp)")
+	f.Bool(forceRelayFlag, false, "Force usage of TURN servers")
+}
+
+func validateCommunityFlags() error {
+	if strings.TrimSpace(viper.GetString(communityFlag)) == "" {
+		return errMissingCommunity
+	}
+
+	if strings.TrimSpace(viper.GetString(passwordFlag)) == "" {
+		return errMissingPassword
+	}
+
+	if strings.TrimSpace(viper.GetString(keyFlag)) == "" {
+		return errMissingKey
+	}
+
+	return nil
+}
+
+func getSignalerURL() (string, error) {
+	u, err := url.Parse(viper.GetString(raddrFlag))
+	if err != nil {
+		return "", err
+	}
+
+	q := u.Query()
+	q.Set("community", viper.GetString(communityFlag))
+	q.Set("password", viper.GetString(passwordFlag))
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pojntfx/weron/internal/daemon"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	nameFlag        = "name"
+	descriptionFlag = "description"
+	watchdogFlag    = "watchdog"
+)
+
+var (
+	errMissingName    = errors.New("missing name")
+	errMissingCommand = errors.New("missing command to run as a service")
+)
+
+var serviceInstallCmd = &cobra.Command{
+	Use:     "install [flags] -- command [command flags]",
+	Aliases: []string{"ins", "i"},
+	Short:   "Install and start a command as a system service",
+	Example: "weron service install --name weron-vpn -- vpn ip --community mycommunity --password mypassword --key mykey --ips 2001:db8::1/32",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		if strings.TrimSpace(viper.GetString(nameFlag)) == "" {
+			return errMissingName
+		}
+
+		if len(args) <= 0 {
+			return errMissingCommand
+		}
+
+		bin, err := os.Executable()
+		if err != nil {
+			return err
+		}
+
+		return daemon.Install(daemon.Unit{
+			Name:        viper.GetString(nameFlag),
+			Description: viper.GetString(descriptionFlag),
+			Exec:        append([]string{bin}, args...),
+			Watchdog:    viper.GetDuration(watchdogFlag),
+		})
+	},
+}
+
+func init() {
+	serviceInstallCmd.PersistentFlags().String(nameFlag, "", "Name of the service (i.e. weron-vpn)")
+	serviceInstallCmd.PersistentFlags().String(descriptionFlag, "weron overlay network", "Description of the service")
+	serviceInstallCmd.PersistentFlags().Duration(watchdogFlag, time.Second*30, "Time after which the service manager should restart an unresponsive service (0 disables the watchdog; only supported on Linux)")
+
+	viper.AutomaticEnv()
+
+	serviceCmd.AddCommand(serviceInstallCmd)
+}
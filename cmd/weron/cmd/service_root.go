@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/pojntfx/weron/internal/daemon"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var serviceCmd = &cobra.Command{
+	Use:     "service",
+	Aliases: []string{"srv"},
+	Short:   "Manage system services for overlay networks",
+}
+
+func init() {
+	viper.AutomaticEnv()
+
+	rootCmd.AddCommand(serviceCmd)
+}
+
+func addServiceManagerNotifier(ctx context.Context) error {
+	if err := daemon.Ready(); err != nil {
+		return err
+	}
+
+	go func() {
+		if err := daemon.Watchdog(ctx); err != nil {
+			log.Debug().Err(err).Msg("Could not send keep-alive to service manager, stopping")
+		}
+	}()
+
+	return nil
+}
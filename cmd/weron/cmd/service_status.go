@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pojntfx/weron/internal/daemon"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var serviceStatusCmd = &cobra.Command{
+	Use:     "status",
+	Aliases: []string{"sta", "s"},
+	Short:   "Show the status of a system service",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		if strings.TrimSpace(viper.GetString(nameFlag)) == "" {
+			return errMissingName
+		}
+
+		status, err := daemon.Status(viper.GetString(nameFlag))
+		if err != nil {
+			return err
+		}
+
+		fmt.Print(status)
+
+		return nil
+	},
+}
+
+func init() {
+	serviceStatusCmd.PersistentFlags().String(nameFlag, "", "Name of the service (i.e. weron-vpn)")
+
+	viper.AutomaticEnv()
+
+	serviceCmd.AddCommand(serviceStatusCmd)
+}
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/pojntfx/weron/internal/daemon"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var serviceUninstallCmd = &cobra.Command{
+	Use:     "uninstall",
+	Aliases: []string{"uns", "u", "rm"},
+	Short:   "Stop and remove a system service",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+			return err
+		}
+
+		if strings.TrimSpace(viper.GetString(nameFlag)) == "" {
+			return errMissingName
+		}
+
+		return daemon.Uninstall(viper.GetString(nameFlag))
+	},
+}
+
+func init() {
+	serviceUninstallCmd.PersistentFlags().String(nameFlag, "", "Name of the service (i.e. weron-vpn)")
+
+	viper.AutomaticEnv()
+
+	serviceCmd.AddCommand(serviceUninstallCmd)
+}
@@ -29,7 +29,7 @@ const (
 
 var signalerCmd = &cobra.Command{
 	Use:     "signaler",
-	Aliases: []string{"sgl", "s"},
+	Aliases: []string{"signal", "sgl", "s"},
 	Short:   "Start a signaling server",
 	PreRunE: func(cmd *cobra.Command, args []string) error {
 		if u := os.Getenv("API_USERNAME"); u != "" {
@@ -127,6 +127,10 @@ var signalerCmd = &cobra.Command{
 		}
 		addInterruptHandler(cancel, signaler, nil)
 
+		if err := addServiceManagerNotifier(ctx); err != nil {
+			return err
+		}
+
 		log.Info().
 			Str("address", addr.String()).
 			Msg("Listening")
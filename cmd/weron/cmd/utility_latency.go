@@ -3,8 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"net/url"
-	"strings"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -32,32 +30,19 @@ var utilityLatencyCommand = &cobra.Command{
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
-		if strings.TrimSpace(viper.GetString(communityFlag)) == "" {
-			return errMissingCommunity
-		}
-
-		if strings.TrimSpace(viper.GetString(passwordFlag)) == "" {
-			return errMissingPassword
-		}
-
-		if strings.TrimSpace(viper.GetString(keyFlag)) == "" {
-			return errMissingKey
+		if err := validateCommunityFlags(); err != nil {
+			return err
 		}
 
 		fmt.Printf("\r\u001b[0K.%v\n", viper.GetString(raddrFlag))
 
-		u, err := url.Parse(viper.GetString(raddrFlag))
+		u, err := getSignalerURL()
 		if err != nil {
 			return err
 		}
 
-		q := u.Query()
-		q.Set("community", viper.GetString(communityFlag))
-		q.Set("password", viper.GetString(passwordFlag))
-		u.RawQuery = q.Encode()
-
 		adapter := wrtcltc.NewAdapter(
-			u.String(),
+			u,
 			viper.GetString(keyFlag),
 			viper.GetStringSlice(iceFlag),
 			&wrtcltc.AdapterConfig{
@@ -138,13 +123,7 @@ var utilityLatencyCommand = &cobra.Command{
 }
 
 func init() {
-	utilityLatencyCommand.PersistentFlags().String(raddrFlag, "wss://weron.herokuapp.com/", "Remote address")
-	utilityLatencyCommand.PersistentFlags().Duration(timeoutFlag, time.Second*10, "Time to wait for connections")
-	utilityLatencyCommand.PersistentFlags().String(communityFlag, "", "ID of community to join")
-	utilityLatencyCommand.PersistentFlags().String(passwordFlag, "", "Password for community")
-	utilityLatencyCommand.PersistentFlags().String(keyFlag, "", "Encryption key for community")
-	utilityLatencyCommand.PersistentFlags().StringSlice(iceFlag, []string{"stun:stun.l.google.com:19302"}, "Comma-separated list of STUN servers (in format stun:host:port) and TURN servers to use (in format username:credential@turn:host:port) (i.e. username:credential@turn:global.turn.twilio.com:3478?transport=tcp)")
-	utilityLatencyCommand.PersistentFlags().Bool(forceRelayFlag, false, "Force usage of TURN servers")
+	addCommunityFlags(utilityLatencyCommand.PersistentFlags())
 	utilityLatencyCommand.PersistentFlags().Bool(serverFlag, false, "Act as a server")
 	utilityLatencyCommand.PersistentFlags().Int(packetLengthFlag, 128, "Size of packet to send and acknowledge")
 	utilityLatencyCommand.PersistentFlags().Duration(pauseFlag, time.Second*1, "Time to wait before sending next packet")
@@ -3,9 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"net/url"
-	"strings"
-	"time"
 
 	"github.com/rs/zerolog/log"
 
@@ -34,32 +31,19 @@ var utilityThroughputCmd = &cobra.Command{
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
-		if strings.TrimSpace(viper.GetString(communityFlag)) == "" {
-			return errMissingCommunity
-		}
-
-		if strings.TrimSpace(viper.GetString(passwordFlag)) == "" {
-			return errMissingPassword
-		}
-
-		if strings.TrimSpace(viper.GetString(keyFlag)) == "" {
-			return errMissingKey
+		if err := validateCommunityFlags(); err != nil {
+			return err
 		}
 
 		fmt.Printf("\r\u001b[0K.%v\n", viper.GetString(raddrFlag))
 
-		u, err := url.Parse(viper.GetString(raddrFlag))
+		u, err := getSignalerURL()
 		if err != nil {
 			return err
 		}
 
-		q := u.Query()
-		q.Set("community", viper.GetString(communityFlag))
-		q.Set("password", viper.GetString(passwordFlag))
-		u.RawQuery = q.Encode()
-
 		adapter := wrtcthr.NewAdapter(
-			u.String(),
+			u,
 			viper.GetString(keyFlag),
 			viper.GetStringSlice(iceFlag),
 			&wrtcthr.AdapterConfig{
@@ -154,13 +138,7 @@ var utilityThroughputCmd = &cobra.Command{
 }
 
 func init() {
-	utilityThroughputCmd.PersistentFlags().String(raddrFlag, "wss://weron.herokuapp.com/", "Remote address")
-	utilityThroughputCmd.PersistentFlags().Duration(timeoutFlag, time.Second*10, "Time to wait for connections")
-	utilityThroughputCmd.PersistentFlags().String(communityFlag, "", "ID of community to join")
-	utilityThroughputCmd.PersistentFlags().String(passwordFlag, "", "Password for community")
-	utilityThroughputCmd.PersistentFlags().String(keyFlag, "", "Encryption key for community")
-	utilityThroughputCmd.PersistentFlags().StringSlice(iceFlag, []string{"stun:stun.l.google.com:19302"}, "Comma-separated list of STUN servers (in format stun:host:port) and TURN servers to use (in format username:credential@turn:host:port) (i.e. username:credential@turn:global.turn.twilio.com:3478?transport=tcp)")
-	utilityThroughputCmd.PersistentFlags().Bool(forceRelayFlag, false, "Force usage of TURN servers")
+	addCommunityFlags(utilityThroughputCmd.PersistentFlags())
 	utilityThroughputCmd.PersistentFlags().Bool(serverFlag, false, "Act as a server")
 	utilityThroughputCmd.PersistentFlags().Int(packetLengthFlag, 50000, "Size of packet to send")
 	utilityThroughputCmd.PersistentFlags().Int(packetCountFlag, 1000, "Amount of packets to send before waiting for acknowledgement")
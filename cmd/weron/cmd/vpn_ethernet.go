@@ -2,10 +2,7 @@ package cmd
 
 import (
 	"context"
-	"net/url"
 	"runtime"
-	"strings"
-	"time"
 
 	"github.com/rs/zerolog/log"
 
@@ -33,30 +30,17 @@ var vpnEthernetCmd = &cobra.Command{
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
-		if strings.TrimSpace(viper.GetString(communityFlag)) == "" {
-			return errMissingCommunity
-		}
-
-		if strings.TrimSpace(viper.GetString(passwordFlag)) == "" {
-			return errMissingPassword
-		}
-
-		if strings.TrimSpace(viper.GetString(keyFlag)) == "" {
-			return errMissingKey
+		if err := validateCommunityFlags(); err != nil {
+			return err
 		}
 
-		u, err := url.Parse(viper.GetString(raddrFlag))
+		u, err := getSignalerURL()
 		if err != nil {
 			return err
 		}
 
-		q := u.Query()
-		q.Set("community", viper.GetString(communityFlag))
-		q.Set("password", viper.GetString(passwordFlag))
-		u.RawQuery = q.Encode()
-
 		adapter := wrtceth.NewAdapter(
-			u.String(),
+			u,
 			viper.GetString(keyFlag),
 			viper.GetStringSlice(iceFlag),
 			&wrtceth.AdapterConfig{
@@ -95,18 +79,16 @@ var vpnEthernetCmd = &cobra.Command{
 		}
 		addInterruptHandler(cancel, adapter, nil)
 
+		if err := addServiceManagerNotifier(ctx); err != nil {
+			return err
+		}
+
 		return adapter.Wait()
 	},
 }
 
 func init() {
-	vpnEthernetCmd.PersistentFlags().String(raddrFlag, "wss://weron.herokuapp.com/", "Remote address")
-	vpnEthernetCmd.PersistentFlags().Duration(timeoutFlag, time.Second*10, "Time to wait for connections")
-	vpnEthernetCmd.PersistentFlags().String(communityFlag, "", "ID of community to join")
-	vpnEthernetCmd.PersistentFlags().String(passwordFlag, "", "Password for community")
-	vpnEthernetCmd.PersistentFlags().String(keyFlag, "", "Encryption key for community")
-	vpnEthernetCmd.PersistentFlags().StringSlice(iceFlag, []string{"stun:stun.l.google.com:19302"}, "Comma-separated list of STUN servers (in format stun:host:port) and TURN servers to use (in format username:credential@turn:host:port) (i.e. username:credential@turn:global.turn.twilio.com:3478?transport=tcp)")
-	vpnEthernetCmd.PersistentFlags().Bool(forceRelayFlag, false, "Force usage of TURN servers")
+	addCommunityFlags(vpnEthernetCmd.PersistentFlags())
 	vpnEthernetCmd.PersistentFlags().String(devFlag, "", "Name to give to the TAP device (i.e. weron0) (default is auto-generated; only supported on Linux, macOS and Windows)")
 	vpnEthernetCmd.PersistentFlags().String(macFlag, "", "MAC address to give to the TAP device (i.e. 3a:f8:de:7b:ef:52) (default is auto-generated; only supported on Linux)")
 	vpnEthernetCmd.PersistentFlags().Int(parallelFlag, runtime.NumCPU(), "Amount of threads to use to decode frames")
@@ -4,9 +4,7 @@ import (
 	"context"
 	"errors"
 	"net"
-	"net/url"
 	"runtime"
-	"strings"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -41,16 +39,8 @@ var vpnIPCmd = &cobra.Command{
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
-		if strings.TrimSpace(viper.GetString(communityFlag)) == "" {
-			return errMissingCommunity
-		}
-
-		if strings.TrimSpace(viper.GetString(passwordFlag)) == "" {
-			return errMissingPassword
-		}
-
-		if strings.TrimSpace(viper.GetString(keyFlag)) == "" {
-			return errMissingKey
+		if err := validateCommunityFlags(); err != nil {
+			return err
 		}
 
 		if len(viper.GetStringSlice(ipsFlag)) <= 0 {
@@ -63,18 +53,13 @@ var vpnIPCmd = &cobra.Command{
 			}
 		}
 
-		u, err := url.Parse(viper.GetString(raddrFlag))
+		u, err := getSignalerURL()
 		if err != nil {
 			return err
 		}
 
-		q := u.Query()
-		q.Set("community", viper.GetString(communityFlag))
-		q.Set("password", viper.GetString(passwordFlag))
-		u.RawQuery = q.Encode()
-
 		adapter := wrtcip.NewAdapter(
-			u.String(),
+			u,
 			viper.GetString(keyFlag),
 			viper.GetStringSlice(iceFlag),
 			&wrtcip.AdapterConfig{
@@ -119,18 +104,16 @@ var vpnIPCmd = &cobra.Command{
 		}
 		addInterruptHandler(cancel, adapter, nil)
 
+		if err := addServiceManagerNotifier(ctx); err != nil {
+			return err
+		}
+
 		return adapter.Wait()
 	},
 }
 
 func init() {
-	vpnIPCmd.PersistentFlags().String(raddrFlag, "wss://weron.herokuapp.com/", "Remote address")
-	vpnIPCmd.PersistentFlags().Duration(timeoutFlag, time.Second*10, "Time to wait for connections")
-	vpnIPCmd.PersistentFlags().String(communityFlag, "", "ID of community to join")
-	vpnIPCmd.PersistentFlags().String(passwordFlag, "", "Password for community")
-	vpnIPCmd.PersistentFlags().String(keyFlag, "", "Encryption key for community")
-	vpnIPCmd.PersistentFlags().StringSlice(iceFlag, []string{"stun:stun.l.google.com:19302"}, "Comma-separated list of STUN servers (in format stun:host:port) and TURN servers to use (in format username:credential@turn:host:port) (i.e. username:credential@turn:global.turn.twilio.com:3478?transport=tcp)")
-	vpnIPCmd.PersistentFlags().Bool(forceRelayFlag, false, "Force usage of TURN servers")
+	addCommunityFlags(vpnIPCmd.PersistentFlags())
 	vpnIPCmd.PersistentFlags().String(devFlag, "", "Name to give to the TAP device (i.e. weron0) (default is auto-generated; only supported on Linux, macOS and Windows)")
 	vpnIPCmd.PersistentFlags().StringSlice(ipsFlag, []string{""}, "Comma-separated list of IP networks to claim an IP address from and and give to the TUN device (i.e. 2001:db8::1/32,192.0.2.1/24) (on Windows, only one IPv4 and one IPv6 address are supported; on macOS, IPv4 addresses are ignored)")
 	vpnIPCmd.PersistentFlags().Bool(staticFlag, false, "Try to claim the exact IPs specified in the --"+ipsFlag+" flag statically instead of selecting a random one from the specified network")
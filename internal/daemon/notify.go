@@ -0,0 +1,90 @@
+package daemon
+
+import (
+	"context"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	notifySocketEnv = "NOTIFY_SOCKET"
+	watchdogUSecEnv = "WATCHDOG_USEC"
+	watchdogPIDEnv  = "WATCHDOG_PID"
+
+	stateReady    = "READY=1"
+	stateStopping = "STOPPING=1"
+	stateWatchdog = "WATCHDOG=1"
+)
+
+// See https://www.freedesktop.org/software/systemd/man/sd_notify.html
+func notify(state string) error {
+	addr := os.Getenv(notifySocketEnv)
+	if addr == "" {
+		return nil // Not started by a service manager which supports notifications
+	}
+
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{
+		Name: addr,
+		Net:  "unixgram",
+	})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Write([]byte(state))
+
+	return err
+}
+
+// Ready notifies the service manager that startup has finished
+func Ready() error {
+	log.Trace().Msg("Notifying service manager of readiness")
+
+	return notify(stateReady)
+}
+
+// Stopping notifies the service manager that shutdown has started
+func Stopping() error {
+	log.Trace().Msg("Notifying service manager of shutdown")
+
+	return notify(stateStopping)
+}
+
+// Watchdog keeps the service manager's watchdog from restarting the service until the context is cancelled
+func Watchdog(ctx context.Context) error {
+	rawInterval := os.Getenv(watchdogUSecEnv)
+	if rawInterval == "" {
+		return nil // Watchdog is disabled
+	}
+
+	if pid := os.Getenv(watchdogPIDEnv); pid != "" && pid != strconv.Itoa(os.Getpid()) {
+		return nil // Watchdog is intended for another process
+	}
+
+	usec, err := strconv.Atoi(rawInterval)
+	if err != nil {
+		return err
+	}
+
+	// Send keep-alives twice per interval as recommended by sd_watchdog_enabled(3)
+	ticker := time.NewTicker((time.Duration(usec) * time.Microsecond) / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			log.Trace().Msg("Sending keep-alive to service manager")
+
+			if err := notify(stateWatchdog); err != nil {
+				return err
+			}
+		}
+	}
+}
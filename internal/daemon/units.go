@@ -0,0 +1,18 @@
+package daemon
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrUnsupportedPlatform = errors.New("service management is not supported on this platform") // The current platform has no supported service manager
+)
+
+// Unit is a service to manage
+type Unit struct {
+	Name        string        // Name of the service
+	Description string        // Human-readable description of the service
+	Exec        []string      // Executable and arguments to start
+	Watchdog    time.Duration // Time after which the service manager should restart the service if it stopped sending keep-alives; 0 disables the watchdog
+}
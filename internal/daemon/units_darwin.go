@@ -0,0 +1,90 @@
+package daemon
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+const (
+	plistDir = "/Library/LaunchDaemons"
+)
+
+func getPlistPath(name string) string {
+	return filepath.Join(plistDir, name+".plist")
+}
+
+func escapeXML(s string) (string, error) {
+	var buf bytes.Buffer
+	if err := xml.EscapeText(&buf, []byte(s)); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// Install creates and loads a launchd daemon
+func Install(unit Unit) error {
+	label, err := escapeXML(unit.Name)
+	if err != nil {
+		return err
+	}
+
+	args := ""
+	for _, arg := range unit.Exec {
+		a, err := escapeXML(arg)
+		if err != nil {
+			return err
+		}
+
+		args += fmt.Sprintf("\t\t<string>%v</string>\n", a)
+	}
+
+	// launchd has no watchdog or readiness protocol, so `KeepAlive` is used instead
+	if err := os.WriteFile(getPlistPath(unit.Name), []byte(fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>%v</string>
+	<key>ProgramArguments</key>
+	<array>
+%v	</array>
+	<key>RunAtLoad</key>
+	<true/>
+	<key>KeepAlive</key>
+	<true/>
+</dict>
+</plist>
+`, label, args)), 0644); err != nil {
+		return err
+	}
+
+	if output, err := exec.Command("launchctl", "load", "-w", getPlistPath(unit.Name)).CombinedOutput(); err != nil {
+		return fmt.Errorf("could not load launchd daemon: %v: %v", string(output), err)
+	}
+
+	return nil
+}
+
+// Uninstall unloads and removes a launchd daemon
+func Uninstall(name string) error {
+	if output, err := exec.Command("launchctl", "unload", "-w", getPlistPath(name)).CombinedOutput(); err != nil {
+		return fmt.Errorf("could not unload launchd daemon: %v: %v", string(output), err)
+	}
+
+	return os.Remove(getPlistPath(name))
+}
+
+// Status returns the status of a launchd daemon
+func Status(name string) (string, error) {
+	output, err := exec.Command("launchctl", "list", name).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("could not get status of launchd daemon: %v: %v", string(output), err)
+	}
+
+	return string(output), nil
+}
@@ -0,0 +1,97 @@
+package daemon
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	unitDir = "/etc/systemd/system"
+)
+
+func getUnitPath(name string) string {
+	return filepath.Join(unitDir, name+".service")
+}
+
+// See https://www.freedesktop.org/software/systemd/man/systemd.service.html#Command%20lines
+func quoteExec(args []string) string {
+	quoted := []string{}
+	for _, arg := range args {
+		quoted = append(quoted, strings.ReplaceAll(strconv.Quote(arg), "%", "%%"))
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+// Install creates, enables and starts a systemd unit
+func Install(unit Unit) error {
+	watchdog := ""
+	if unit.Watchdog > 0 {
+		watchdog = fmt.Sprintf("WatchdogSec=%v\n", int(unit.Watchdog.Seconds()))
+	}
+
+	if err := os.WriteFile(getUnitPath(unit.Name), []byte(fmt.Sprintf(`[Unit]
+Description=%v
+Wants=network-online.target
+After=network-online.target
+
+[Service]
+Type=notify
+NotifyAccess=main
+ExecStart=%v
+Restart=always
+RestartSec=5
+%vAmbientCapabilities=CAP_NET_ADMIN
+
+[Install]
+WantedBy=multi-user.target
+`, unit.Description, quoteExec(unit.Exec), watchdog)), 0644); err != nil {
+		return err
+	}
+
+	if output, err := exec.Command("systemctl", "daemon-reload").CombinedOutput(); err != nil {
+		return fmt.Errorf("could not reload systemd units: %v: %v", string(output), err)
+	}
+
+	if output, err := exec.Command("systemctl", "enable", "--now", unit.Name).CombinedOutput(); err != nil {
+		return fmt.Errorf("could not enable systemd unit: %v: %v", string(output), err)
+	}
+
+	return nil
+}
+
+// Uninstall stops, disables and removes a systemd unit
+func Uninstall(name string) error {
+	if output, err := exec.Command("systemctl", "disable", "--now", name).CombinedOutput(); err != nil {
+		return fmt.Errorf("could not disable systemd unit: %v: %v", string(output), err)
+	}
+
+	if err := os.Remove(getUnitPath(name)); err != nil {
+		return err
+	}
+
+	if output, err := exec.Command("systemctl", "daemon-reload").CombinedOutput(); err != nil {
+		return fmt.Errorf("could not reload systemd units: %v: %v", string(output), err)
+	}
+
+	return nil
+}
+
+// Status returns the status of a systemd unit
+func Status(name string) (string, error) {
+	output, err := exec.Command("systemctl", "status", "--no-pager", name).CombinedOutput()
+	if err != nil {
+		// `systemctl status` exits with a non-zero code for inactive units, which is not an error
+		if _, ok := err.(*exec.ExitError); ok && len(output) > 0 {
+			return string(output), nil
+		}
+
+		return "", fmt.Errorf("could not get status of systemd unit: %v: %v", string(output), err)
+	}
+
+	return string(output), nil
+}
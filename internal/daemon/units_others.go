@@ -0,0 +1,19 @@
+//go:build !(windows || linux || darwin)
+// +build !windows,!linux,!darwin
+
+package daemon
+
+// Install creates and starts a service
+func Install(unit Unit) error {
+	return ErrUnsupportedPlatform
+}
+
+// Uninstall stops and removes a service
+func Uninstall(name string) error {
+	return ErrUnsupportedPlatform
+}
+
+// Status returns the status of a service
+func Status(name string) (string, error) {
+	return "", ErrUnsupportedPlatform
+}
@@ -0,0 +1,51 @@
+package daemon
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"syscall"
+)
+
+// Install creates and starts a scheduled task which runs on boot
+//
+// A scheduled task is used instead of a Windows service because services need to implement the service control protocol
+func Install(unit Unit) error {
+	args := []string{}
+	for _, arg := range unit.Exec {
+		args = append(args, syscall.EscapeArg(arg))
+	}
+
+	if output, err := exec.Command("schtasks", "/Create", "/F", "/TN", unit.Name, "/TR", strings.Join(args, " "), "/SC", "ONSTART", "/RU", "SYSTEM").CombinedOutput(); err != nil {
+		return fmt.Errorf("could not create scheduled task: %v: %v", string(output), err)
+	}
+
+	if output, err := exec.Command("schtasks", "/Run", "/TN", unit.Name).CombinedOutput(); err != nil {
+		return fmt.Errorf("could not start scheduled task: %v: %v", string(output), err)
+	}
+
+	return nil
+}
+
+// Uninstall stops and removes a scheduled task
+func Uninstall(name string) error {
+	if output, err := exec.Command("schtasks", "/End", "/TN", name).CombinedOutput(); err != nil {
+		return fmt.Errorf("could not stop scheduled task: %v: %v", string(output), err)
+	}
+
+	if output, err := exec.Command("schtasks", "/Delete", "/F", "/TN", name).CombinedOutput(); err != nil {
+		return fmt.Errorf("could not delete scheduled task: %v: %v", string(output), err)
+	}
+
+	return nil
+}
+
+// Status returns the status of a scheduled task
+func Status(name string) (string, error) {
+	output, err := exec.Command("schtasks", "/Query", "/V", "/FO", "LIST", "/TN", name).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("could not get status of scheduled task: %v: %v", string(output), err)
+	}
+
+	return string(output), nil
+}
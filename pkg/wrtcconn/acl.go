@@ -0,0 +1,47 @@
+package wrtcconn
+
+const (
+	aclWildcard = "*"
+)
+
+// ACLRule restricts a channel to a set of peers
+type ACLRule struct {
+	Channel string   `json:"channel"` // Channel to restrict ("*" matches all channels)
+	Peers   []string `json:"peers"`   // IDs of peers which may open the channel ("*" matches all peers)
+	Targets []string `json:"targets"` // IDs of peers which may accept the channel ("*" matches all peers)
+}
+
+// ACL defines which peers may open which channels to which other peers
+//
+// Channels without a matching rule are allowed for all peers; channels with at least one matching rule are only allowed if one of the rules matches both peers.
+type ACL []ACLRule
+
+func matchesACLPeer(candidates []string, id string) bool {
+	for _, candidate := range candidates {
+		if candidate == aclWildcard || candidate == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Allows checks whether a channel may be opened between the local and the remote peer
+func (a ACL) Allows(local string, remote string, channelID string) bool {
+	restricted := false
+	for _, rule := range a {
+		if rule.Channel != aclWildcard && rule.Channel != channelID {
+			continue
+		}
+
+		restricted = true
+
+		// Data channels are bidirectional, so the rule can match in either direction
+		if (matchesACLPeer(rule.Peers, local) && matchesACLPeer(rule.Targets, remote)) ||
+			(matchesACLPeer(rule.Peers, remote) && matchesACLPeer(rule.Targets, local)) {
+			return true
+		}
+	}
+
+	return !restricted
+}